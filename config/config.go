@@ -0,0 +1,226 @@
+// Package config resolves git-lfs configuration from git config and the
+// process environment.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/git-lfs/git-lfs/git"
+)
+
+// Values holds the raw configuration sources used to build a
+// *Configuration. It exists primarily so tests can construct a
+// Configuration without touching the real git config or environment.
+type Values struct {
+	Git Environment
+	Os  Environment
+}
+
+// Configuration resolves git-lfs settings from git config, falling back to
+// the process environment where git-lfs has historically honored
+// environment variables.
+type Configuration struct {
+	Git Environment
+	Os  Environment
+
+	// ref is the currently checked out ref, used to resolve
+	// branch-scoped config such as branch.<name>.remote. It is nil when
+	// there is no current branch (e.g. a detached HEAD).
+	ref *git.Ref
+}
+
+// New returns a Configuration backed by the real git config and process
+// environment.
+func New() *Configuration {
+	return NewFrom(Values{})
+}
+
+// NewFrom returns a Configuration backed by the given Values. It is mostly
+// useful in tests, where callers want to control exactly what git config and
+// environment variables are visible to the Configuration under test.
+func NewFrom(v Values) *Configuration {
+	return &Configuration{
+		Git: normalizeEnvironment(v.Git),
+		Os:  normalizeEnvironment(v.Os),
+	}
+}
+
+// Remote returns the name of the remote that the current branch is
+// configured to track, falling back to "origin" if the branch has no remote
+// configured, or there is no current branch.
+func (c *Configuration) Remote() string {
+	if c.ref != nil {
+		if v, ok := c.Git.Get(fmt.Sprintf("branch.%s.remote", c.ref.Name)); ok && len(v) > 0 {
+			return v
+		}
+	}
+	return "origin"
+}
+
+// PushRemote returns the name of the remote that the current branch should
+// push to. It prefers, in order: branch.<name>.pushRemote, remote.pushDefault,
+// and finally falls back to Remote().
+func (c *Configuration) PushRemote() string {
+	if c.ref != nil {
+		if v, ok := c.Git.Get(fmt.Sprintf("branch.%s.pushRemote", c.ref.Name)); ok && len(v) > 0 {
+			return v
+		}
+	}
+	if v, ok := c.Git.Get("remote.pushDefault"); ok && len(v) > 0 {
+		return v
+	}
+	return c.Remote()
+}
+
+// lookupBool resolves a boolean lfs.* setting, preferring a remote-scoped
+// override (lfs.<remote>.<key>) over the global one (lfs.<key>). The remote
+// is whatever Remote() currently resolves to, so branch.<name>.remote is
+// honored the same way it is for Remote() itself; the push remote
+// (branch.<name>.pushRemote, remote.pushDefault) is not consulted.
+// An unparsable value is treated the same as an unset one.
+func (c *Configuration) lookupBool(key string, def bool) bool {
+	if remote := c.Remote(); len(remote) > 0 {
+		if v, ok := c.Git.Get(fmt.Sprintf("lfs.%s.%s", remote, key)); ok {
+			if b, ok := gitBool(v); ok {
+				return b
+			}
+		}
+	}
+
+	if v, ok := c.Git.Get(fmt.Sprintf("lfs.%s", key)); ok {
+		if b, ok := gitBool(v); ok {
+			return b
+		}
+	}
+
+	return def
+}
+
+// gitBool parses a git config boolean value, accepting the same spellings
+// git itself does (true/yes/on/1 and false/no/off/0, case-insensitively),
+// not just the narrower set strconv.ParseBool understands.
+func gitBool(value string) (b bool, ok bool) {
+	switch strings.ToLower(value) {
+	case "true", "yes", "on", "1":
+		return true, true
+	case "false", "no", "off", "0":
+		return false, true
+	}
+	return false, false
+}
+
+// BasicTransfersOnly returns whether the "basic" transfer adapter should be
+// used exclusively, disabling any other registered transfer adapters (such
+// as tus.io resumable uploads). It can be scoped per-remote via
+// lfs.<remote>.basictransfersonly, falling back to lfs.basictransfersonly.
+func (c *Configuration) BasicTransfersOnly() bool {
+	return c.lookupBool("basictransfersonly", false)
+}
+
+// TusTransfersAllowed returns whether the tus.io resumable upload adapter may
+// be used. It can be scoped per-remote via lfs.<remote>.tustransfers,
+// falling back to lfs.tustransfers.
+func (c *Configuration) TusTransfersAllowed() bool {
+	return c.lookupBool("tustransfers", false)
+}
+
+// FetchIncludePaths returns the cleaned set of path prefixes that lfs.fetchinclude
+// restricts fetching to.
+func (c *Configuration) FetchIncludePaths() []string {
+	return c.cleanPaths("lfs.fetchinclude")
+}
+
+// FetchExcludePaths returns the cleaned set of path prefixes that
+// lfs.fetchexclude excludes from fetching.
+func (c *Configuration) FetchExcludePaths() []string {
+	return c.cleanPaths("lfs.fetchexclude")
+}
+
+func (c *Configuration) cleanPaths(key string) []string {
+	v, ok := c.Git.Get(key)
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	paths := strings.Split(v, ",")
+	cleaned := make([]string, 0, len(paths))
+	for _, p := range paths {
+		p = strings.TrimSpace(p)
+		if len(p) == 0 {
+			continue
+		}
+		cleaned = append(cleaned, filepath.Clean(p))
+	}
+	return cleaned
+}
+
+// RepositoryPermissions returns the file mode that new objects in the LFS
+// storage directory should be created with, honoring core.sharedRepository
+// the same way git itself does.
+func (c *Configuration) RepositoryPermissions() os.FileMode {
+	v, ok := c.Git.Get("core.sharedrepository")
+	if !ok {
+		return os.FileMode(0666 & ^umask())
+	}
+
+	switch strings.ToLower(v) {
+	case "group", "true", "1", "yes":
+		return 0660
+	case "all", "world", "everybody", "2":
+		return 0664
+	case "false", "umask", "0", "no":
+		return os.FileMode(0666 & ^umask())
+	}
+
+	if mode, err := strconv.ParseInt(v, 8, 32); err == nil {
+		return os.FileMode(mode)
+	}
+
+	return os.FileMode(0666 & ^umask())
+}
+
+// CurrentCommitter returns the name and email that should be recorded as the
+// committer: user.name/user.email (with the EMAIL environment variable used
+// if user.email is unset), with GIT_COMMITTER_NAME and GIT_COMMITTER_EMAIL
+// each independently overriding their half of the pair when set, exactly as
+// git itself honors them.
+func (c *Configuration) CurrentCommitter() (name, email string) {
+	name, email = c.currentUser()
+	if n, ok := c.Os.Get("GIT_COMMITTER_NAME"); ok {
+		name = n
+	}
+	if e, ok := c.Os.Get("GIT_COMMITTER_EMAIL"); ok {
+		email = e
+	}
+	return name, email
+}
+
+// CurrentAuthor returns the name and email that should be recorded as the
+// author: user.name/user.email (with the EMAIL environment variable used if
+// user.email is unset), with GIT_AUTHOR_NAME and GIT_AUTHOR_EMAIL each
+// independently overriding their half of the pair when set, exactly as git
+// itself honors them.
+func (c *Configuration) CurrentAuthor() (name, email string) {
+	name, email = c.currentUser()
+	if n, ok := c.Os.Get("GIT_AUTHOR_NAME"); ok {
+		name = n
+	}
+	if e, ok := c.Os.Get("GIT_AUTHOR_EMAIL"); ok {
+		email = e
+	}
+	return name, email
+}
+
+func (c *Configuration) currentUser() (name, email string) {
+	name, _ = c.Git.Get("user.name")
+	if e, ok := c.Git.Get("user.email"); ok {
+		email = e
+	} else if e, ok := c.Os.Get("EMAIL"); ok {
+		email = e
+	}
+	return name, email
+}
@@ -32,6 +32,20 @@ func TestRemoteBranchConfig(t *testing.T) {
 	assert.Equal(t, "a", cfg.PushRemote())
 }
 
+func TestRemoteBranchNameIsCaseSensitive(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"branch.Feature.remote": []string{"a"},
+			"branch.feature.remote": []string{"b"},
+		},
+	})
+	cfg.ref = &git.Ref{Name: "Feature"}
+	assert.Equal(t, "a", cfg.Remote())
+
+	cfg.ref = &git.Ref{Name: "feature"}
+	assert.Equal(t, "b", cfg.Remote())
+}
+
 func TestRemotePushDefault(t *testing.T) {
 	cfg := NewFrom(Values{
 		Git: map[string][]string{
@@ -89,6 +103,62 @@ func TestBasicTransfersOnlyInvalidValue(t *testing.T) {
 	assert.Equal(t, false, b)
 }
 
+func TestBasicTransfersOnlyRemoteScopedOverridesGlobal(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"branch.master.remote":          []string{"mirror"},
+			"lfs.basictransfersonly":        []string{"false"},
+			"lfs.mirror.basictransfersonly": []string{"true"},
+		},
+	})
+	cfg.ref = &git.Ref{Name: "master"}
+
+	assert.Equal(t, true, cfg.BasicTransfersOnly())
+}
+
+func TestBasicTransfersOnlyFallsBackToGlobal(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"branch.master.remote":   []string{"mirror"},
+			"lfs.basictransfersonly": []string{"true"},
+		},
+	})
+	cfg.ref = &git.Ref{Name: "master"}
+
+	assert.Equal(t, true, cfg.BasicTransfersOnly())
+}
+
+func TestBasicTransfersOnlyFallsBackToDefault(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"branch.master.remote": []string{"mirror"},
+		},
+	})
+	cfg.ref = &git.Ref{Name: "master"}
+
+	assert.Equal(t, false, cfg.BasicTransfersOnly())
+}
+
+func TestBasicTransfersOnlyAcceptsGitBooleanSpellings(t *testing.T) {
+	for _, value := range []string{"yes", "on", "Yes", "ON"} {
+		cfg := NewFrom(Values{
+			Git: map[string][]string{
+				"lfs.basictransfersonly": []string{value},
+			},
+		})
+		assert.Equal(t, true, cfg.BasicTransfersOnly())
+	}
+
+	for _, value := range []string{"no", "off", "No", "OFF"} {
+		cfg := NewFrom(Values{
+			Git: map[string][]string{
+				"lfs.basictransfersonly": []string{value},
+			},
+		})
+		assert.Equal(t, false, cfg.BasicTransfersOnly())
+	}
+}
+
 func TestTusTransfersAllowedSetValue(t *testing.T) {
 	cfg := NewFrom(Values{
 		Git: map[string][]string{
@@ -118,6 +188,36 @@ func TestTusTransfersAllowedInvalidValue(t *testing.T) {
 	assert.Equal(t, false, b)
 }
 
+func TestTusTransfersAllowedIgnoresPushRemoteScope(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"branch.master.pushRemote": []string{"mirror"},
+			"remote.pushDefault":       []string{"other"},
+			"lfs.tustransfers":         []string{"false"},
+			"lfs.mirror.tustransfers":  []string{"true"},
+		},
+	})
+	cfg.ref = &git.Ref{Name: "master"}
+
+	// BasicTransfersOnly/TusTransfersAllowed are resolved against the
+	// fetch/clone remote, not the push remote, so branch.master.remote
+	// (here unset, falling back to "origin") is what's consulted.
+	assert.Equal(t, false, cfg.TusTransfersAllowed())
+}
+
+func TestTusTransfersAllowedRemoteScopedFromFetchRemote(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"branch.master.remote":    []string{"mirror"},
+			"lfs.tustransfers":        []string{"false"},
+			"lfs.mirror.tustransfers": []string{"true"},
+		},
+	})
+	cfg.ref = &git.Ref{Name: "master"}
+
+	assert.Equal(t, true, cfg.TusTransfersAllowed())
+}
+
 func TestLoadValidExtension(t *testing.T) {
 	cfg := NewFrom(Values{
 		Git: map[string][]string{
@@ -127,7 +227,7 @@ func TestLoadValidExtension(t *testing.T) {
 		},
 	})
 
-	ext := cfg.Extensions()["foo"]
+	ext := cfg.RawExtensions()["foo"]
 
 	assert.Equal(t, "foo", ext.Name)
 	assert.Equal(t, "foo-clean %f", ext.Clean)
@@ -137,7 +237,7 @@ func TestLoadValidExtension(t *testing.T) {
 
 func TestLoadInvalidExtension(t *testing.T) {
 	cfg := NewFrom(Values{})
-	ext := cfg.Extensions()["foo"]
+	ext := cfg.RawExtensions()["foo"]
 
 	assert.Equal(t, "", ext.Name)
 	assert.Equal(t, "", ext.Clean)
@@ -145,6 +245,187 @@ func TestLoadInvalidExtension(t *testing.T) {
 	assert.Equal(t, 0, ext.Priority)
 }
 
+func TestResolveExtensionChainOrdersByPriority(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean":    []string{"foo-clean %f"},
+			"lfs.extension.foo.smudge":   []string{"foo-smudge %f"},
+			"lfs.extension.foo.priority": []string{"2"},
+			"lfs.extension.bar.clean":    []string{"bar-clean %f"},
+			"lfs.extension.bar.smudge":   []string{"bar-smudge %f"},
+			"lfs.extension.bar.priority": []string{"1"},
+		},
+	})
+
+	chain, err := cfg.ResolveExtensionChain("foo", "bar")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bar", "foo"}, extensionNames(chain))
+}
+
+func TestExtensionsTiebreaksByName(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean":  []string{"foo-clean %f"},
+			"lfs.extension.foo.smudge": []string{"foo-smudge %f"},
+			"lfs.extension.bar.clean":  []string{"bar-clean %f"},
+			"lfs.extension.bar.smudge": []string{"bar-smudge %f"},
+		},
+	})
+
+	sorted, err := cfg.Extensions()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bar", "foo"}, extensionNames(sorted))
+}
+
+func TestExtensionsSkipsDisabled(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean":   []string{"foo-clean %f"},
+			"lfs.extension.foo.smudge":  []string{"foo-smudge %f"},
+			"lfs.extension.foo.enabled": []string{"false"},
+			"lfs.extension.bar.clean":   []string{"bar-clean %f"},
+			"lfs.extension.bar.smudge":  []string{"bar-smudge %f"},
+		},
+	})
+
+	sorted, err := cfg.Extensions()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bar"}, extensionNames(sorted))
+}
+
+func TestExtensionsMissingHook(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean": []string{"foo-clean %f"},
+		},
+	})
+
+	sorted, err := cfg.Extensions()
+	assert.Nil(t, sorted)
+	assert.Error(t, err)
+}
+
+func TestExtensionsReportsInvalidExtensionDeterministically(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean": []string{"foo-clean %f"},
+			"lfs.extension.bar.clean": []string{"bar-clean %f"},
+		},
+	})
+
+	_, err := cfg.Extensions()
+	if assert.Error(t, err) {
+		extErr, ok := err.(*ExtensionError)
+		if assert.True(t, ok) {
+			assert.Equal(t, "bar", extErr.Name)
+		}
+	}
+}
+
+func TestExtensionNameIsCaseSensitive(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.Foo.clean":  []string{"Foo-clean %f"},
+			"lfs.extension.Foo.smudge": []string{"Foo-smudge %f"},
+			"lfs.extension.foo.clean":  []string{"foo-clean %f"},
+			"lfs.extension.foo.smudge": []string{"foo-smudge %f"},
+		},
+	})
+
+	sorted, err := cfg.Extensions()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Foo", "foo"}, extensionNames(sorted))
+}
+
+func TestResolveExtensionChainMissingHook(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean": []string{"foo-clean %f"},
+		},
+	})
+
+	chain, err := cfg.ResolveExtensionChain("foo")
+	assert.Nil(t, chain)
+	if assert.Error(t, err) {
+		extErr, ok := err.(*ExtensionError)
+		if assert.True(t, ok) {
+			assert.Equal(t, "foo", extErr.Name)
+		}
+	}
+}
+
+func TestResolveExtensionChainReportsGap(t *testing.T) {
+	cfg := NewFrom(Values{})
+
+	chain, err := cfg.ResolveExtensionChain("foo")
+	assert.Nil(t, chain)
+	if assert.Error(t, err) {
+		extErr, ok := err.(*ExtensionError)
+		if assert.True(t, ok) {
+			assert.Equal(t, "foo", extErr.Name)
+		}
+	}
+}
+
+func TestResolveExtensionChainPriorityCollision(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean":    []string{"foo-clean %f"},
+			"lfs.extension.foo.smudge":   []string{"foo-smudge %f"},
+			"lfs.extension.foo.priority": []string{"1"},
+			"lfs.extension.bar.clean":    []string{"bar-clean %f"},
+			"lfs.extension.bar.smudge":   []string{"bar-smudge %f"},
+			"lfs.extension.bar.priority": []string{"1"},
+		},
+	})
+
+	chain, err := cfg.ResolveExtensionChain("foo", "bar")
+	assert.Nil(t, chain)
+	assert.Error(t, err)
+}
+
+func TestResolveExtensionChainSkipsDisabled(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean":   []string{"foo-clean %f"},
+			"lfs.extension.foo.smudge":  []string{"foo-smudge %f"},
+			"lfs.extension.foo.enabled": []string{"false"},
+		},
+	})
+
+	chain, err := cfg.ResolveExtensionChain("foo")
+	assert.Nil(t, chain)
+	if assert.Error(t, err) {
+		extErr, ok := err.(*ExtensionError)
+		if assert.True(t, ok) {
+			assert.Equal(t, "disabled", extErr.Reason)
+		}
+	}
+}
+
+func TestDisabledExtensions(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"lfs.extension.foo.clean":   []string{"foo-clean %f"},
+			"lfs.extension.foo.smudge":  []string{"foo-smudge %f"},
+			"lfs.extension.foo.enabled": []string{"false"},
+			"lfs.extension.bar.clean":   []string{"bar-clean %f"},
+			"lfs.extension.bar.smudge":  []string{"bar-smudge %f"},
+		},
+	})
+
+	disabled := cfg.DisabledExtensions()
+	assert.Equal(t, []string{"foo"}, extensionNames(disabled))
+}
+
+func extensionNames(extensions []Extension) []string {
+	names := make([]string, len(extensions))
+	for i, ext := range extensions {
+		names[i] = ext.Name
+	}
+	return names
+}
+
 func TestFetchIncludeExcludesAreCleaned(t *testing.T) {
 	cfg := NewFrom(Values{
 		Git: map[string][]string{
@@ -255,3 +536,33 @@ func TestCurrentUser(t *testing.T) {
 	assert.Equal(t, name, "Sam Roe")
 	assert.Equal(t, email, "sroe@example.net")
 }
+
+func TestCurrentCommitterHonorsNameAndEmailOverridesIndependently(t *testing.T) {
+	cfg := NewFrom(Values{
+		Git: map[string][]string{
+			"user.name":  []string{"Pat Doe"},
+			"user.email": []string{"pdoe@example.org"},
+		},
+		Os: map[string][]string{
+			"GIT_COMMITTER_NAME": []string{"Sam Roe"},
+		},
+	})
+
+	name, email := cfg.CurrentCommitter()
+	assert.Equal(t, "Sam Roe", name)
+	assert.Equal(t, "pdoe@example.org", email)
+
+	cfg = NewFrom(Values{
+		Git: map[string][]string{
+			"user.name":  []string{"Pat Doe"},
+			"user.email": []string{"pdoe@example.org"},
+		},
+		Os: map[string][]string{
+			"GIT_COMMITTER_EMAIL": []string{"sroe@example.net"},
+		},
+	})
+
+	name, email = cfg.CurrentCommitter()
+	assert.Equal(t, "Pat Doe", name)
+	assert.Equal(t, "sroe@example.net", email)
+}
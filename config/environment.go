@@ -0,0 +1,61 @@
+package config
+
+import "strings"
+
+// Environment is a flattened view of configuration key/value pairs, as read
+// from either `git config` or the process environment. Git config keys are
+// looked up the way git itself matches them: the section and the final
+// variable name are case-insensitive, but a subsection (e.g. the branch name
+// in branch.<name>.remote, or the extension name in lfs.extension.<name>.clean)
+// is case-sensitive. Values are stored as a slice because both git config and
+// the environment allow a key to be set more than once; the last value wins.
+type Environment map[string][]string
+
+// Get returns the last value set for the given key, and whether it was set
+// at all.
+func (e Environment) Get(key string) (string, bool) {
+	v, ok := e.GetAll(key)
+	if !ok || len(v) == 0 {
+		return "", false
+	}
+	return v[len(v)-1], true
+}
+
+// GetAll returns every value set for the given key, in the order they were
+// defined, and whether the key was set at all.
+func (e Environment) GetAll(key string) ([]string, bool) {
+	if e == nil {
+		return nil, false
+	}
+	v, ok := e[normalizeConfigKey(key)]
+	return v, ok
+}
+
+// normalizeEnvironment normalizes every key in e, so that later lookups can
+// rely on Get/GetAll's matching regardless of how the caller cased the keys
+// they supplied.
+func normalizeEnvironment(e Environment) Environment {
+	normalized := make(Environment, len(e))
+	for key, values := range e {
+		normalized[normalizeConfigKey(key)] = values
+	}
+	return normalized
+}
+
+// normalizeConfigKey lowercases the section (the part before the first dot)
+// and the variable name (the part after the last dot) of a dotted config
+// key, while leaving any subsection in between untouched. This mirrors git's
+// own config key matching: "branch.Master.remote" and "branch.master.remote"
+// are different keys, but "Branch.master.Remote" and "branch.master.remote"
+// are the same one. Environment variable names (which have no dots) are
+// simply lowercased in full.
+func normalizeConfigKey(key string) string {
+	parts := strings.Split(key, ".")
+	if len(parts) < 2 {
+		return strings.ToLower(key)
+	}
+
+	parts[0] = strings.ToLower(parts[0])
+	parts[len(parts)-1] = strings.ToLower(parts[len(parts)-1])
+	return strings.Join(parts, ".")
+}
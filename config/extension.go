@@ -0,0 +1,192 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// extensionMapNames returns the keys of extensions, sorted, so that code
+// iterating over it (e.g. to validate each entry) does so in a fixed order
+// rather than Go's randomized map order.
+func extensionMapNames(extensions map[string]Extension) []string {
+	names := make([]string, 0, len(extensions))
+	for name := range extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Extension describes a single clean/smudge filter pair registered under
+// lfs.extension.<name>.*. Extensions form a pipeline: files are piped
+// through each enabled extension's clean (or smudge) command in ascending
+// Priority order.
+type Extension struct {
+	Name     string
+	Clean    string
+	Smudge   string
+	Priority int
+	Enabled  bool
+}
+
+// ExtensionError reports a problem with a single named extension's
+// configuration, such as a missing clean/smudge command or an unresolvable
+// priority collision.
+type ExtensionError struct {
+	Name   string
+	Reason string
+}
+
+func (e *ExtensionError) Error() string {
+	return fmt.Sprintf("lfs.extension.%s: %s", e.Name, e.Reason)
+}
+
+var extensionKeyRe = regexp.MustCompile(`^lfs\.extension\.([^.]+)\.(clean|smudge|priority|enabled)$`)
+
+// allExtensions scans git config for every lfs.extension.<name>.* setting
+// and assembles them into Extension values, keyed by name. An extension
+// whose lfs.extension.<name>.enabled is unset defaults to enabled; an
+// unparsable "enabled" value is likewise treated as enabled. It backs
+// RawExtensions, Extensions, DisabledExtensions, and ResolveExtensionChain.
+func (c *Configuration) allExtensions() map[string]Extension {
+	extensions := make(map[string]Extension)
+
+	for key := range c.Git {
+		m := extensionKeyRe.FindStringSubmatch(key)
+		if m == nil {
+			continue
+		}
+
+		name, field := m[1], m[2]
+		ext, ok := extensions[name]
+		if !ok {
+			ext = Extension{Name: name, Enabled: true}
+		}
+
+		value, _ := c.Git.Get(key)
+		switch field {
+		case "clean":
+			ext.Clean = value
+		case "smudge":
+			ext.Smudge = value
+		case "priority":
+			if p, err := strconv.Atoi(value); err == nil {
+				ext.Priority = p
+			}
+		case "enabled":
+			if b, ok := gitBool(value); ok {
+				ext.Enabled = b
+			}
+		}
+
+		extensions[name] = ext
+	}
+
+	return extensions
+}
+
+// RawExtensions returns every configured extension, keyed by name,
+// regardless of whether it is enabled or fully configured. Use Extensions
+// or ResolveExtensionChain to get a validated, ordered pipeline instead.
+func (c *Configuration) RawExtensions() map[string]Extension {
+	return c.allExtensions()
+}
+
+// Extensions returns every enabled, fully configured extension, ordered by
+// ascending Priority with ties broken by name. It returns an *ExtensionError
+// if an enabled extension is missing its clean or smudge command; when more
+// than one is invalid, the one that sorts first by name is reported, so the
+// error is stable across calls rather than depending on map iteration order.
+func (c *Configuration) Extensions() ([]Extension, error) {
+	all := c.allExtensions()
+
+	var sorted []Extension
+	for _, name := range extensionMapNames(all) {
+		ext := all[name]
+		if !ext.Enabled {
+			continue
+		}
+		if len(ext.Clean) == 0 || len(ext.Smudge) == 0 {
+			return nil, &ExtensionError{Name: ext.Name, Reason: "missing clean or smudge command"}
+		}
+		sorted = append(sorted, ext)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Priority == sorted[j].Priority {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Priority < sorted[j].Priority
+	})
+
+	return sorted, nil
+}
+
+// DisabledExtensions returns the extensions whose lfs.extension.<name>.enabled
+// is explicitly set to false, sorted by name.
+func (c *Configuration) DisabledExtensions() []Extension {
+	var disabled []Extension
+	for _, ext := range c.allExtensions() {
+		if !ext.Enabled {
+			disabled = append(disabled, ext)
+		}
+	}
+
+	sort.Slice(disabled, func(i, j int) bool {
+		return disabled[i].Name < disabled[j].Name
+	})
+
+	return disabled
+}
+
+// ResolveExtensionChain returns the named extensions, in priority order
+// (ties broken by name), validated and ready to run as a pipeline. It
+// returns an *ExtensionError if a named extension doesn't exist, is
+// disabled, is missing its clean or smudge command, or shares a priority
+// with another extension in the chain.
+func ResolveExtensionChain(extensions map[string]Extension, names ...string) ([]Extension, error) {
+	chain := make([]Extension, 0, len(names))
+
+	for _, name := range names {
+		ext, ok := extensions[name]
+		if !ok {
+			return nil, &ExtensionError{Name: name, Reason: "not configured"}
+		}
+		if !ext.Enabled {
+			return nil, &ExtensionError{Name: name, Reason: "disabled"}
+		}
+		if len(ext.Clean) == 0 || len(ext.Smudge) == 0 {
+			return nil, &ExtensionError{Name: name, Reason: "missing clean or smudge command"}
+		}
+		chain = append(chain, ext)
+	}
+
+	sort.Slice(chain, func(i, j int) bool {
+		if chain[i].Priority == chain[j].Priority {
+			return chain[i].Name < chain[j].Name
+		}
+		return chain[i].Priority < chain[j].Priority
+	})
+
+	seen := make(map[int]string, len(chain))
+	for _, ext := range chain {
+		if other, ok := seen[ext.Priority]; ok {
+			return nil, &ExtensionError{
+				Name:   ext.Name,
+				Reason: fmt.Sprintf("priority %d collides with extension %q", ext.Priority, other),
+			}
+		}
+		seen[ext.Priority] = ext.Name
+	}
+
+	return chain, nil
+}
+
+// ResolveExtensionChain returns the named extensions from this
+// Configuration's git config, in priority order. See the package-level
+// ResolveExtensionChain for the validation rules applied.
+func (c *Configuration) ResolveExtensionChain(names ...string) ([]Extension, error) {
+	return ResolveExtensionChain(c.allExtensions(), names...)
+}
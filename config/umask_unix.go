@@ -0,0 +1,29 @@
+//go:build !windows
+// +build !windows
+
+package config
+
+import (
+	"sync"
+	"syscall"
+)
+
+var (
+	umaskOnce  sync.Once
+	umaskCache int
+)
+
+// umask returns the process umask. syscall.Umask has the side effect of
+// setting it, which would race with any concurrent file creation elsewhere
+// in the process, so the probe (set to 0, then restore) is only ever made
+// once; the result is
+// cached for the lifetime of the process (the umask is process-wide and
+// essentially never changes after startup).
+func umask() int {
+	umaskOnce.Do(func() {
+		mask := syscall.Umask(0)
+		syscall.Umask(mask)
+		umaskCache = mask
+	})
+	return umaskCache
+}
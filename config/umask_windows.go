@@ -0,0 +1,9 @@
+//go:build windows
+// +build windows
+
+package config
+
+// umask returns 0 on Windows, which has no umask concept.
+func umask() int {
+	return 0
+}
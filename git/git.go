@@ -0,0 +1,24 @@
+package git
+
+// RefType describes the kind of ref (branch, tag, etc) that a Ref points
+// at.
+type RefType int
+
+const (
+	RefTypeLocalBranch RefType = iota
+	RefTypeRemoteBranch
+	RefTypeLocalTag
+	RefTypeRemoteTag
+	RefTypeHEAD
+	RefTypeOther
+)
+
+// Ref is a git ref, usually the currently checked out branch.
+type Ref struct {
+	// Name is the short name of this ref, e.g. "master".
+	Name string
+	// Type is the type of ref that this Ref represents.
+	Type RefType
+	// Sha is the commit SHA that this ref points at, if known.
+	Sha string
+}